@@ -0,0 +1,190 @@
+// Copyright 2020 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"github.com/k14s/ytt/pkg/yamlmeta"
+)
+
+// OpenAPIDocument is the root of an OpenAPI 3.0 document containing a single
+// `components.schemas` entry describing a DocumentSchema's shape.
+type OpenAPIDocument struct {
+	OpenAPI    string              `json:"openapi" yaml:"openapi"`
+	Info       OpenAPIInfo         `json:"info" yaml:"info"`
+	Paths      map[string]struct{} `json:"paths" yaml:"paths"`
+	Components OpenAPIComponents   `json:"components" yaml:"components"`
+}
+
+type OpenAPIInfo struct {
+	Title   string `json:"title" yaml:"title"`
+	Version string `json:"version" yaml:"version"`
+}
+
+type OpenAPIComponents struct {
+	Schemas map[string]*OpenAPISchema `json:"schemas" yaml:"schemas"`
+}
+
+// OpenAPISchema is a (deliberately partial) rendering of an OpenAPI 3.0 Schema
+// Object — only the keywords ytt's schema annotations can produce are included.
+type OpenAPISchema struct {
+	Type                 string                    `json:"type,omitempty" yaml:"type,omitempty"`
+	Nullable             bool                      `json:"nullable,omitempty" yaml:"nullable,omitempty"`
+	Default              interface{}               `json:"default,omitempty" yaml:"default,omitempty"`
+	Properties           map[string]*OpenAPISchema `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Items                *OpenAPISchema            `json:"items,omitempty" yaml:"items,omitempty"`
+	PrefixItems          []*OpenAPISchema          `json:"prefixItems,omitempty" yaml:"prefixItems,omitempty"`
+	Minimum              *float64                  `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	Maximum              *float64                  `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	MinLength            *int                      `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	MaxLength            *int                      `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	MinItems             *int                      `json:"minItems,omitempty" yaml:"minItems,omitempty"`
+	MaxItems             *int                      `json:"maxItems,omitempty" yaml:"maxItems,omitempty"`
+	MinProperties        *int                      `json:"minProperties,omitempty" yaml:"minProperties,omitempty"`
+	MaxProperties        *int                      `json:"maxProperties,omitempty" yaml:"maxProperties,omitempty"`
+	Enum                 []interface{}             `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Pattern              string                    `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Example              interface{}               `json:"example,omitempty" yaml:"example,omitempty"`
+	Examples             map[string]OpenAPIExample `json:"examples,omitempty" yaml:"examples,omitempty"`
+}
+
+// OpenAPIExample is one entry of an OpenAPISchema's `examples` map.
+type OpenAPIExample struct {
+	Summary string      `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Value   interface{} `json:"value" yaml:"value"`
+}
+
+// NewOpenAPIDocument renders s's type tree as an OpenAPI 3.0 document, one
+// `components.schemas` entry keyed by s.Name.
+func NewOpenAPIDocument(s *DocumentSchema) *OpenAPIDocument {
+	return &OpenAPIDocument{
+		OpenAPI: "3.0.0",
+		Info: OpenAPIInfo{
+			Title:   "ytt data values schema",
+			Version: "0.1.0",
+		},
+		Paths: map[string]struct{}{},
+		Components: OpenAPIComponents{
+			Schemas: map[string]*OpenAPISchema{
+				s.Name: newOpenAPISchema(s.Allowed.ValueType, nil),
+			},
+		},
+	}
+}
+
+func newOpenAPISchema(t yamlmeta.Type, defaultValue interface{}) *OpenAPISchema {
+	schema := &OpenAPISchema{Default: defaultValue}
+
+	switch typedType := t.(type) {
+	case *yamlmeta.MapType:
+		schema.Type = "object"
+		schema.Properties = map[string]*OpenAPISchema{}
+		for _, item := range typedType.Items {
+			key, ok := item.Key.(string)
+			if !ok {
+				continue
+			}
+			itemSchema := newOpenAPISchema(item.ValueType, item.DefaultValue)
+			if item.IsNullable() {
+				itemSchema.Nullable = true
+			}
+			applyValidation(itemSchema, item.Validation)
+			applyExamples(itemSchema, item.Examples)
+			schema.Properties[key] = itemSchema
+		}
+		return schema
+	case *yamlmeta.ArrayType:
+		schema.Type = "array"
+		if typedType.Tuple != nil {
+			for _, itemType := range typedType.Tuple {
+				prefixSchema := newOpenAPISchema(itemType.ValueType, itemType.DefaultValue)
+				if itemType.Nullable {
+					prefixSchema.Nullable = true
+				}
+				applyValidation(prefixSchema, itemType.Validation)
+				applyExamples(prefixSchema, itemType.Examples)
+				schema.PrefixItems = append(schema.PrefixItems, prefixSchema)
+			}
+			return schema
+		}
+		itemSchema := newOpenAPISchema(typedType.ItemsType.ValueType, nil)
+		if typedType.ItemsType.Nullable {
+			itemSchema.Nullable = true
+		}
+		applyValidation(itemSchema, typedType.ItemsType.Validation)
+		applyExamples(itemSchema, typedType.ItemsType.Examples)
+		schema.Items = itemSchema
+		return schema
+	case *yamlmeta.ScalarType:
+		schema.Type = openAPITypeFor(typedType.Type)
+		applyValidation(schema, typedType.Validation)
+		return schema
+	case *yamlmeta.AnyType:
+		// `{}` — no constraints — unless one_of narrows it to an enumeration.
+		if typedType.Validation != nil && typedType.Validation.OneOf != nil {
+			schema.Nullable = true
+			schema.Enum = typedType.Validation.OneOf
+		}
+		return schema
+	}
+
+	return schema
+}
+
+func openAPITypeFor(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case int, int64:
+		return "integer"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return "object"
+	}
+}
+
+// applyExamples renders @schema/examples as OpenAPI's `example` (single example)
+// or `examples` (two or more, keyed by their description) fields.
+func applyExamples(schema *OpenAPISchema, examples []yamlmeta.Example) {
+	if len(examples) == 0 {
+		return
+	}
+	if len(examples) == 1 {
+		schema.Example = examples[0].Value
+		return
+	}
+
+	schema.Examples = map[string]OpenAPIExample{}
+	for _, example := range examples {
+		schema.Examples[example.Description] = OpenAPIExample{Summary: example.Description, Value: example.Value}
+	}
+}
+
+func applyValidation(schema *OpenAPISchema, validation *yamlmeta.Validation) {
+	if validation == nil {
+		return
+	}
+
+	schema.Minimum = validation.Min
+	schema.Maximum = validation.Max
+	schema.Pattern = ""
+	if validation.Pattern != nil {
+		schema.Pattern = *validation.Pattern
+	}
+	schema.Enum = validation.OneOf
+
+	switch schema.Type {
+	case "string":
+		schema.MinLength = validation.MinLen
+		schema.MaxLength = validation.MaxLen
+	case "array":
+		schema.MinItems = validation.MinLen
+		schema.MaxItems = validation.MaxLen
+	case "object":
+		schema.MinProperties = validation.MinLen
+		schema.MaxProperties = validation.MaxLen
+	}
+}
@@ -20,9 +20,10 @@ type AnySchema struct {
 }
 
 type DocumentSchema struct {
-	Name    string
-	Source  *yamlmeta.Document
-	Allowed *yamlmeta.DocumentType
+	Name     string
+	Source   *yamlmeta.Document
+	Allowed  *yamlmeta.DocumentType
+	Defaults *yamlmeta.Defaults
 }
 
 func NewDocumentSchema(doc *yamlmeta.Document) (*DocumentSchema, error) {
@@ -45,9 +46,10 @@ func NewDocumentSchema(doc *yamlmeta.Document) (*DocumentSchema, error) {
 		docType.ValueType = valueType
 	}
 	return &DocumentSchema{
-		Name:    "dataValues",
-		Source:  doc,
-		Allowed: docType,
+		Name:     "dataValues",
+		Source:   doc,
+		Allowed:  docType,
+		Defaults: yamlmeta.NewDefaults(docType.ValueType),
 	}, nil
 }
 
@@ -84,19 +86,65 @@ func NewMapItemType(item *yamlmeta.MapItem) (*yamlmeta.MapItemType, error) {
 	if _, nullable := templateAnnotations[yamlmeta.AnnotationSchemaNullable]; nullable {
 		defaultValue = nil
 	}
+	if overridden, found, err := yamlmeta.NewDefaultValue(item); err != nil {
+		return nil, err
+	} else if found {
+		defaultValue = overridden
+	}
 	annotations := make(yamlmeta.TypeAnnotations)
 	for key, val := range templateAnnotations {
 		annotations[key] = val
 	}
 
-	return &yamlmeta.MapItemType{Key: item.Key, ValueType: valueType, DefaultValue: defaultValue, Position: item.Position, Annotations: annotations}, nil
+	validation, err := yamlmeta.NewValidation(item, valueType)
+	if err != nil {
+		return nil, err
+	}
+	if scalarType, ok := valueType.(*yamlmeta.ScalarType); ok {
+		scalarType.Validation = validation
+		scalarType.DefaultValue = defaultValue
+	}
+
+	valueType, err = yamlmeta.NewTypeFromAnn(item, valueType, defaultValue)
+	if err != nil {
+		return nil, err
+	}
+	if anyType, ok := valueType.(*yamlmeta.AnyType); ok {
+		anyType.Validation = validation
+	}
+
+	_, nullable := templateAnnotations[yamlmeta.AnnotationSchemaNullable]
+	examples, err := yamlmeta.NewExamples(item, valueType, nullable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &yamlmeta.MapItemType{Key: item.Key, ValueType: valueType, DefaultValue: defaultValue, Position: item.Position, Annotations: annotations, Validation: validation, Examples: examples}, nil
 }
 
 func NewArrayType(a *yamlmeta.Array) (*yamlmeta.ArrayType, error) {
-	// These really are distinct use cases. In the empty list, perhaps the user is unaware that arrays must be typed. In the >1 scenario, they may be expecting the given items to be the defaults.
+	// These really are distinct use cases. In the empty list, perhaps the user is unaware that arrays must be typed. In the >1 scenario (outside of @schema/type tuple=True), they may be expecting the given items to be the defaults.
 	if len(a.Items) == 0 {
 		return nil, fmt.Errorf("Expected one item in array (describing the type of its elements) at %s", a.Position.AsCompactString())
 	}
+
+	typeKwargs, err := yamlmeta.SchemaTypeKwargs(a)
+	if err != nil {
+		return nil, err
+	}
+
+	if typeKwargs["tuple"] {
+		var tuple []*yamlmeta.ArrayItemType
+		for _, arrayItem := range a.Items {
+			itemType, err := NewArrayItemType(arrayItem)
+			if err != nil {
+				return nil, err
+			}
+			tuple = append(tuple, itemType)
+		}
+		return &yamlmeta.ArrayType{Tuple: tuple}, nil
+	}
+
 	if len(a.Items) > 1 {
 		return nil, fmt.Errorf("Expected one item (found %v) in array (describing the type of its elements) at %s", len(a.Items), a.Position.AsCompactString())
 	}
@@ -116,12 +164,45 @@ func NewArrayItemType(item *yamlmeta.ArrayItem) (*yamlmeta.ArrayItemType, error)
 	}
 
 	annotations := template.NewAnnotations(item)
+	_, nullable := annotations[yamlmeta.AnnotationSchemaNullable]
 
-	if _, found := annotations[yamlmeta.AnnotationSchemaNullable]; found {
-		return nil, fmt.Errorf("Array items cannot be annotated with #@schema/nullable (%s). If this behaviour would be valuable, please submit an issue on https://github.com/vmware-tanzu/carvel-ytt", item.GetPosition().AsCompactString())
+	validation, err := yamlmeta.NewValidation(item, valueType)
+	if err != nil {
+		return nil, err
+	}
+	if scalarType, ok := valueType.(*yamlmeta.ScalarType); ok {
+		scalarType.Validation = validation
+	}
+
+	arrayItemType := &yamlmeta.ArrayItemType{ValueType: valueType, Validation: validation, Nullable: nullable}
+	if nullable {
+		arrayItemType.DefaultValue = nil
+	}
+	if overridden, found, err := yamlmeta.NewDefaultValue(item); err != nil {
+		return nil, err
+	} else if found {
+		arrayItemType.DefaultValue = overridden
+		if scalarType, ok := valueType.(*yamlmeta.ScalarType); ok {
+			scalarType.DefaultValue = overridden
+		}
+	}
+
+	valueType, err = yamlmeta.NewTypeFromAnn(item, valueType, arrayItemType.DefaultValue)
+	if err != nil {
+		return nil, err
+	}
+	if anyType, ok := valueType.(*yamlmeta.AnyType); ok {
+		anyType.Validation = validation
 	}
+	arrayItemType.ValueType = valueType
 
-	return &yamlmeta.ArrayItemType{ValueType: valueType}, nil
+	examples, err := yamlmeta.NewExamples(item, valueType, nullable)
+	if err != nil {
+		return nil, err
+	}
+	arrayItemType.Examples = examples
+
+	return arrayItemType, nil
 }
 
 func newCollectionItemValueType(collectionItemValue interface{}) (yamlmeta.Type, error) {
@@ -142,6 +223,8 @@ func newCollectionItemValueType(collectionItemValue interface{}) (yamlmeta.Type,
 		return &yamlmeta.ScalarType{Type: *new(string)}, nil
 	case int:
 		return &yamlmeta.ScalarType{Type: *new(int)}, nil
+	case float64:
+		return &yamlmeta.ScalarType{Type: *new(float64)}, nil
 	case bool:
 		return &yamlmeta.ScalarType{Type: *new(bool)}, nil
 	}
@@ -154,5 +237,24 @@ func (as *AnySchema) AssignType(typeable yamlmeta.Typeable) yamlmeta.TypeCheck {
 }
 
 func (s *DocumentSchema) AssignType(typeable yamlmeta.Typeable) yamlmeta.TypeCheck {
-	return s.Allowed.AssignTypeTo(typeable)
+	if doc, ok := typeable.(*yamlmeta.Document); ok {
+		if m, ok := doc.Value.(*yamlmeta.Map); ok {
+			s.Defaults.ApplyTo(m)
+		}
+	}
+
+	check := s.Allowed.AssignTypeTo(typeable)
+
+	if doc, ok := typeable.(*yamlmeta.Document); ok {
+		check.Violations = append(check.Violations, yamlmeta.CheckValidations(s.Allowed.ValueType, doc.Value)...)
+	}
+
+	return check
+}
+
+// NewOpenAPIDocument translates this DocumentSchema into an OpenAPI 3.0 document
+// whose `components.schemas` describe the same shape and constraints, so that a
+// ytt schema can be published as a contract rather than stay an internal type gate.
+func (s *DocumentSchema) NewOpenAPIDocument() *OpenAPIDocument {
+	return NewOpenAPIDocument(s)
 }
@@ -0,0 +1,79 @@
+// Copyright 2020 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package yamlmeta
+
+import (
+	"fmt"
+
+	"github.com/k14s/ytt/pkg/structmeta"
+	"github.com/k14s/ytt/pkg/template"
+	"github.com/k14s/ytt/pkg/template/core"
+)
+
+const (
+	AnnotationSchemaType structmeta.AnnotationName = "schema/type"
+)
+
+// AnyType is the @schema/type any=True escape hatch: it short-circuits
+// AssignTypeTo/CheckType to always succeed, letting a sub-tree hold
+// heterogeneous values (e.g. Kubernetes metadata.annotations, arbitrary
+// provider config blobs) while still carrying a default and, optionally, an
+// enumeration of allowed values via @schema/validation one_of.
+type AnyType struct {
+	DefaultValue interface{}
+	Validation   *Validation
+}
+
+func (t *AnyType) AssignTypeTo(Typeable) TypeCheck { return TypeCheck{} }
+func (t *AnyType) CheckType(Typeable) TypeCheck    { return TypeCheck{} }
+
+// SchemaTypeKwargs parses the boolean kwargs of node's @schema/type annotation
+// (if present), e.g. `any=True` or `tuple=True`.
+func SchemaTypeKwargs(node Node) (map[string]bool, error) {
+	kwargs := map[string]bool{}
+
+	anns := template.NewAnnotations(node)
+	annotation, found := anns[AnnotationSchemaType]
+	if !found {
+		return kwargs, nil
+	}
+
+	for _, kwarg := range annotation.Kwargs {
+		name, err := core.NewStarlarkValue(kwarg[0]).AsString()
+		if err != nil {
+			return nil, fmt.Errorf("Processing %s (%s): %s", AnnotationSchemaType, node.GetPosition().AsCompactString(), err)
+		}
+		if name != "any" && name != "tuple" {
+			return nil, fmt.Errorf("Unknown kwarg %q for %s (%s)", name, AnnotationSchemaType, node.GetPosition().AsCompactString())
+		}
+
+		goValue, err := core.NewStarlarkValue(kwarg[1]).AsGoValue()
+		if err != nil {
+			return nil, fmt.Errorf("Processing %s (%s): %s", AnnotationSchemaType, node.GetPosition().AsCompactString(), err)
+		}
+		boolValue, ok := goValue.(bool)
+		if !ok {
+			return nil, fmt.Errorf("Expected %s kwarg %q to be a boolean (%s)", AnnotationSchemaType, name, node.GetPosition().AsCompactString())
+		}
+		kwargs[name] = boolValue
+	}
+
+	return kwargs, nil
+}
+
+// NewTypeFromAnn inspects node's @schema/type annotation (if present) and, when
+// its any kwarg is True, wraps defaultValue in an AnyType that replaces
+// valueType — any=True composes with @schema/validation one_of to enforce
+// membership rather than structural typing.
+func NewTypeFromAnn(node Node, valueType Type, defaultValue interface{}) (Type, error) {
+	kwargs, err := SchemaTypeKwargs(node)
+	if err != nil {
+		return nil, err
+	}
+	if !kwargs["any"] {
+		return valueType, nil
+	}
+
+	return &AnyType{DefaultValue: defaultValue}, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package yamlmeta
+
+import (
+	"fmt"
+
+	"github.com/k14s/ytt/pkg/structmeta"
+	"github.com/k14s/ytt/pkg/template"
+	"github.com/k14s/ytt/pkg/template/core"
+)
+
+const (
+	AnnotationSchemaDefault structmeta.AnnotationName = "schema/default"
+)
+
+// NewDefaultValue evaluates the single positional argument to @schema/default (if
+// present) as a starlark expression, returning found=false when the annotation is
+// absent so callers fall back to their usual default-value inference.
+func NewDefaultValue(node Node) (value interface{}, found bool, err error) {
+	anns := template.NewAnnotations(node)
+	annotation, found := anns[AnnotationSchemaDefault]
+	if !found {
+		return nil, false, nil
+	}
+	if len(annotation.Args) != 1 {
+		return nil, false, fmt.Errorf("Expected %s to have exactly one argument (%s)", AnnotationSchemaDefault, node.GetPosition().AsCompactString())
+	}
+
+	goValue, err := core.NewStarlarkValue(annotation.Args[0]).AsGoValue()
+	if err != nil {
+		return nil, false, fmt.Errorf("Processing %s (%s): %s", AnnotationSchemaDefault, node.GetPosition().AsCompactString(), err)
+	}
+
+	defaultValue, err := toDefaultNode(node, goValue)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return defaultValue, true, nil
+}
+
+// toDefaultNode converts goValue (as produced by core.StarlarkValue.AsGoValue) into
+// the shape the rest of the schema pipeline expects a default value to have: scalars
+// pass through unchanged, but a list becomes a *Array of *ArrayItems so that
+// Defaults.ApplyTo and type-checking see the same yamlmeta AST nodes they'd see for a
+// value parsed straight out of YAML. A map value is rejected outright: AsGoValue's
+// dict shape isn't one this package round-trips into a *Map elsewhere, so guessing at
+// a conversion risks silently losing or reordering keys.
+func toDefaultNode(node Node, goValue interface{}) (interface{}, error) {
+	switch typed := goValue.(type) {
+	case []interface{}:
+		array := &Array{Position: node.GetPosition()}
+		for _, elem := range typed {
+			converted, err := toDefaultNode(node, elem)
+			if err != nil {
+				return nil, err
+			}
+			array.Items = append(array.Items, &ArrayItem{Value: converted, Position: node.GetPosition()})
+		}
+		return array, nil
+	case string, int, int64, float64, bool, nil:
+		return typed, nil
+	default:
+		return nil, fmt.Errorf("Processing %s (%s): map values are not supported for default; set defaults on the map's individual items instead", AnnotationSchemaDefault, node.GetPosition().AsCompactString())
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2020 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package yamlmeta
+
+// Defaults mirrors the map part of a DocumentSchema's type tree, holding the value
+// to use when a user-supplied document omits the corresponding node entirely. Keys
+// preserves the schema's own item order so ApplyTo inserts missing items
+// deterministically rather than in Go's randomized map-iteration order. Only map
+// items get child entries: an array's default (homogeneous or tuple) is held
+// whole in Value, and its elements have no defaults of their own.
+type Defaults struct {
+	Value    interface{}
+	Keys     []interface{}
+	Children map[interface{}]*Defaults
+}
+
+// NewDefaults builds the defaults tree parallel to t, the root type of a
+// DocumentSchema.
+func NewDefaults(t Type) *Defaults {
+	switch typedType := t.(type) {
+	case *MapType:
+		return newMapDefaults(typedType)
+	default:
+		return &Defaults{}
+	}
+}
+
+func newMapDefaults(m *MapType) *Defaults {
+	keys := make([]interface{}, 0, len(m.Items))
+	children := map[interface{}]*Defaults{}
+	for _, item := range m.Items {
+		keys = append(keys, item.Key)
+		children[item.Key] = newItemDefaults(item.DefaultValue, item.ValueType)
+	}
+	return &Defaults{Keys: keys, Children: children}
+}
+
+func newItemDefaults(defaultValue interface{}, t Type) *Defaults {
+	if mapType, ok := t.(*MapType); ok {
+		defaults := newMapDefaults(mapType)
+		defaults.Value = defaultValue
+		return defaults
+	}
+	return &Defaults{Value: defaultValue}
+}
+
+// ApplyTo fills in node's missing map items from d, in pre-order: at each node,
+// first populate missing children from the defaults sub-tree, then recurse into
+// each (now present) child so that nested defaults apply the same way. A user who
+// supplies a partial map therefore gets nested fields filled from defaults before
+// child type-check runs. ApplyTo does not descend into arrays. Each inserted
+// default is deep-copied (a map default is rebuilt) so that documents
+// checked against the same schema never share (and mutate) the same AST node.
+func (d *Defaults) ApplyTo(node *Map) {
+	if d == nil {
+		return
+	}
+
+	for _, key := range d.Keys {
+		childDefaults := d.Children[key]
+		item := findMapItemByKey(node, key)
+		if item == nil {
+			value := deepCopyValue(childDefaults.Value)
+			if defaultMap, ok := childDefaults.Value.(*Map); ok && childDefaults.Children != nil {
+				// rebuilt from its own sub-tree (below) rather than copied, so that
+				// nested item defaults apply to it too
+				value = &Map{Position: defaultMap.Position}
+			}
+			item = &MapItem{Key: key, Value: value}
+			node.Items = append(node.Items, item)
+		}
+		if childMap, ok := item.Value.(*Map); ok {
+			childDefaults.ApplyTo(childMap)
+		}
+	}
+}
+
+// deepCopyValue clones value if it is a collection AST node (the schema's own
+// literal, which must not be shared/mutated across documents) and returns value
+// unchanged otherwise (scalars are already immutable).
+func deepCopyValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case *Map:
+		return deepCopyMap(typed)
+	case *Array:
+		return deepCopyArray(typed)
+	default:
+		return value
+	}
+}
+
+func deepCopyMap(m *Map) *Map {
+	if m == nil {
+		return nil
+	}
+	copied := &Map{Position: m.Position}
+	for _, item := range m.Items {
+		copied.Items = append(copied.Items, &MapItem{Key: item.Key, Value: deepCopyValue(item.Value), Position: item.Position})
+	}
+	return copied
+}
+
+func deepCopyArray(a *Array) *Array {
+	if a == nil {
+		return nil
+	}
+	copied := &Array{Position: a.Position}
+	for _, item := range a.Items {
+		copied.Items = append(copied.Items, &ArrayItem{Value: deepCopyValue(item.Value), Position: item.Position})
+	}
+	return copied
+}
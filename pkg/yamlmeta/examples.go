@@ -0,0 +1,113 @@
+// Copyright 2020 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package yamlmeta
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/k14s/ytt/pkg/structmeta"
+	"github.com/k14s/ytt/pkg/template"
+	"github.com/k14s/ytt/pkg/template/core"
+	"go.starlark.net/starlark"
+)
+
+const (
+	AnnotationSchemaExamples structmeta.AnnotationName = "schema/examples"
+)
+
+// Example is one (description, example value) pair declared via @schema/examples.
+type Example struct {
+	Description string
+	Value       interface{}
+}
+
+// NewExamples parses the @schema/examples annotation (if present) off of node's
+// annotations, validating each argument is a 2-tuple of (description string,
+// example value), and that the example value is assignable to valueType — unless
+// it is `null`, which is only accepted when nullable is true.
+func NewExamples(node Node, valueType Type, nullable bool) ([]Example, error) {
+	anns := template.NewAnnotations(node)
+	annotation, found := anns[AnnotationSchemaExamples]
+	if !found {
+		return nil, nil
+	}
+	if len(annotation.Args) == 0 {
+		return nil, fmt.Errorf("Expected at least one example for %s (%s)", AnnotationSchemaExamples, node.GetPosition().AsCompactString())
+	}
+
+	var examples []Example
+	for _, arg := range annotation.Args {
+		tuple, ok := arg.(starlark.Tuple)
+		if !ok || tuple.Len() != 2 {
+			return nil, fmt.Errorf("Processing %s (%s): found: %s for @schema/examples, expected: 2-tuple containing description (string) and example value (of expected type)", AnnotationSchemaExamples, node.GetPosition().AsCompactString(), arg.Type())
+		}
+
+		description, err := core.NewStarlarkValue(tuple.Index(0)).AsString()
+		if err != nil {
+			return nil, fmt.Errorf("Processing %s (%s): description must be a string: %s", AnnotationSchemaExamples, node.GetPosition().AsCompactString(), err)
+		}
+
+		exampleValue, err := core.NewStarlarkValue(tuple.Index(1)).AsGoValue()
+		if err != nil {
+			return nil, fmt.Errorf("Processing %s (%s): %s", AnnotationSchemaExamples, node.GetPosition().AsCompactString(), err)
+		}
+
+		if exampleValue == nil {
+			if !nullable {
+				return nil, fmt.Errorf("Processing %s (%s): example value is null, but %s is not annotated with #@schema/nullable", AnnotationSchemaExamples, node.GetPosition().AsCompactString(), node.GetPosition().AsCompactString())
+			}
+		} else if !isAssignableTo(exampleValue, valueType) {
+			return nil, fmt.Errorf("Processing %s (%s): example value %#v is not assignable to type of %s", AnnotationSchemaExamples, node.GetPosition().AsCompactString(), exampleValue, node.GetPosition().AsCompactString())
+		}
+
+		examples = append(examples, Example{Description: description, Value: exampleValue})
+	}
+
+	return examples, nil
+}
+
+// isAssignableTo reports whether value could occupy a node declared as type t. It is
+// shared by two callers with different dynamic shapes for collection values:
+// CheckValidations/ArrayType.AssignTypeTo pass parsed *Map/*Array AST nodes, while
+// NewExamples and one_of pass native Go values straight out of starlark's
+// AsGoValue (map[string]interface{}/[]interface{}) -- so the map/array cases accept
+// either shape.
+func isAssignableTo(value interface{}, t Type) bool {
+	switch typed := t.(type) {
+	case *AnyType:
+		return true
+	case *ScalarType:
+		switch typed.Type.(type) {
+		case string:
+			_, ok := value.(string)
+			return ok
+		case int:
+			switch value.(type) {
+			case int, int64:
+				return true
+			}
+			return false
+		case bool:
+			_, ok := value.(bool)
+			return ok
+		case float64:
+			_, ok := value.(float64)
+			return ok
+		}
+		return true
+	case *MapType:
+		if _, ok := value.(*Map); ok {
+			return true
+		}
+		return value != nil && reflect.ValueOf(value).Kind() == reflect.Map
+	case *ArrayType:
+		if _, ok := value.(*Array); ok {
+			return true
+		}
+		return value != nil && reflect.ValueOf(value).Kind() == reflect.Slice
+	default:
+		return true
+	}
+}
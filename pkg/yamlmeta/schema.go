@@ -5,6 +5,8 @@ package yamlmeta
 
 import (
 	"fmt"
+
+	"github.com/k14s/ytt/pkg/filepos"
 	"github.com/k14s/ytt/pkg/structmeta"
 	"github.com/k14s/ytt/pkg/template"
 )
@@ -18,7 +20,7 @@ const (
 )
 
 func schemaAnnotationsList() []structmeta.AnnotationName {
-	return []structmeta.AnnotationName{AnnotationSchemaNullable}
+	return []structmeta.AnnotationName{AnnotationSchemaNullable, AnnotationSchemaValidation, AnnotationSchemaDefault, AnnotationSchemaExamples, AnnotationSchemaType}
 }
 
 var _ Schema = &AnySchema{}
@@ -28,9 +30,10 @@ type AnySchema struct {
 }
 
 type DocumentSchema struct {
-	Name    string
-	Source  *Document
-	Allowed *DocumentType
+	Name     string
+	Source   *Document
+	Allowed  *DocumentType
+	Defaults *Defaults
 }
 
 func NewDocumentSchema(doc *Document) (*DocumentSchema, error) {
@@ -53,9 +56,10 @@ func NewDocumentSchema(doc *Document) (*DocumentSchema, error) {
 		docType.ValueType = valueType
 	}
 	return &DocumentSchema{
-		Name:    "dataValues",
-		Source:  doc,
-		Allowed: docType,
+		Name:     "dataValues",
+		Source:   doc,
+		Allowed:  docType,
+		Defaults: NewDefaults(docType.ValueType),
 	}, nil
 }
 
@@ -95,25 +99,71 @@ func NewMapItemType(item *MapItem) (*MapItemType, error) {
 	if _, nullable := annotations[AnnotationSchemaNullable]; nullable {
 		defaultValue = nil
 	}
+	if overridden, found, err := NewDefaultValue(item); err != nil {
+		return nil, err
+	} else if found {
+		defaultValue = overridden
+	}
+
+	validation, err := NewValidation(item, valueType)
+	if err != nil {
+		return nil, err
+	}
+	if scalarType, ok := valueType.(*ScalarType); ok {
+		scalarType.Validation = validation
+		scalarType.DefaultValue = defaultValue
+	}
+
+	valueType, err = NewTypeFromAnn(item, valueType, defaultValue)
+	if err != nil {
+		return nil, err
+	}
+	if anyType, ok := valueType.(*AnyType); ok {
+		anyType.Validation = validation
+	}
+
+	_, nullable := annotations[AnnotationSchemaNullable]
+	examples, err := NewExamples(item, valueType, nullable)
+	if err != nil {
+		return nil, err
+	}
 
-	return &MapItemType{Key: item.Key, ValueType: valueType, DefaultValue: defaultValue, Position: item.Position, annotations: annotations}, nil
+	return &MapItemType{Key: item.Key, ValueType: valueType, DefaultValue: defaultValue, Position: item.Position, annotations: annotations, Validation: validation, Examples: examples}, nil
 }
 
 func NewArrayType(a *Array) (*ArrayType, error) {
-	// These really are distinct use cases. In the empty list, perhaps the user is unaware that arrays must be typed. In the >1 scenario, they may be expecting the given items to be the defaults.
+	// These really are distinct use cases. In the empty list, perhaps the user is unaware that arrays must be typed. In the >1 scenario (outside of @schema/type tuple=True), they may be expecting the given items to be the defaults.
 	if len(a.Items) == 0 {
 		return nil, fmt.Errorf("Expected one item in array (describing the type of its elements) at %s", a.Position.AsCompactString())
 	}
-	if len(a.Items) > 1 {
-		return nil, fmt.Errorf("Expected one item (found %v) in array (describing the type of its elements) at %s", len(a.Items), a.Position.AsCompactString())
+
+	annotations, err := schemaAnnotations(a)
+	if err != nil {
+		return nil, err
 	}
 
-	arrayItemType, err := NewArrayItemType(a.Items[0])
+	typeKwargs, err := SchemaTypeKwargs(a)
 	if err != nil {
 		return nil, err
 	}
 
-	annotations, err := schemaAnnotations(a)
+	if typeKwargs["tuple"] {
+		var tuple []*ArrayItemType
+		for _, arrayItem := range a.Items {
+			itemType, err := NewArrayItemType(arrayItem)
+			if err != nil {
+				return nil, err
+			}
+			tuple = append(tuple, itemType)
+		}
+		return &ArrayType{Tuple: tuple, annotations: annotations}, nil
+	}
+
+	if len(a.Items) > 1 {
+		return nil, fmt.Errorf("Expected one item (found %v) in array (describing the type of its elements) at %s", len(a.Items), a.Position.AsCompactString())
+	}
+
+	arrayItemType, err := NewArrayItemType(a.Items[0])
 	if err != nil {
 		return nil, err
 	}
@@ -131,11 +181,45 @@ func NewArrayItemType(item *ArrayItem) (*ArrayItemType, error) {
 	if err != nil {
 		return nil, err
 	}
-	if _, found := annotations[AnnotationSchemaNullable]; found {
-		return nil, fmt.Errorf("Array items cannot be annotated with #@schema/nullable (%s). If this behaviour would be valuable, please submit an issue on https://github.com/vmware-tanzu/carvel-ytt", item.GetPosition().AsCompactString())
+	_, nullable := annotations[AnnotationSchemaNullable]
+
+	validation, err := NewValidation(item, valueType)
+	if err != nil {
+		return nil, err
+	}
+	if scalarType, ok := valueType.(*ScalarType); ok {
+		scalarType.Validation = validation
+	}
+
+	arrayItemType := &ArrayItemType{ValueType: valueType, Validation: validation, Nullable: nullable}
+	if nullable {
+		arrayItemType.DefaultValue = nil
+	}
+	if overridden, found, err := NewDefaultValue(item); err != nil {
+		return nil, err
+	} else if found {
+		arrayItemType.DefaultValue = overridden
+		if scalarType, ok := valueType.(*ScalarType); ok {
+			scalarType.DefaultValue = overridden
+		}
+	}
+
+	valueType, err = NewTypeFromAnn(item, valueType, arrayItemType.DefaultValue)
+	if err != nil {
+		return nil, err
+	}
+	if anyType, ok := valueType.(*AnyType); ok {
+		anyType.Validation = validation
 	}
+	arrayItemType.ValueType = valueType
 
-	return &ArrayItemType{ValueType: valueType}, nil
+	examples, err := NewExamples(item, valueType, nullable)
+	if err != nil {
+		return nil, err
+	}
+	arrayItemType.Examples = examples
+
+	return arrayItemType, nil
 }
 
 func newCollectionItemValueType(collectionItemValue interface{}) (Type, error) {
@@ -156,6 +240,8 @@ func newCollectionItemValueType(collectionItemValue interface{}) (Type, error) {
 		return &ScalarType{Type: *new(string)}, nil
 	case int:
 		return &ScalarType{Type: *new(int)}, nil
+	case float64:
+		return &ScalarType{Type: *new(float64)}, nil
 	case bool:
 		return &ScalarType{Type: *new(bool)}, nil
 	}
@@ -165,8 +251,147 @@ func newCollectionItemValueType(collectionItemValue interface{}) (Type, error) {
 
 func (as *AnySchema) AssignType(typeable Typeable) TypeCheck { return TypeCheck{} }
 
+// AssignTypeTo is ArrayType's half of the type-check walk. A Tuple-shaped array
+// (ItemsType is nil, built via @schema/type tuple=True) checks the value's
+// length and each element against its own index's type, rather than a single
+// homogeneous ItemsType -- this is the branch the rest of the walk was missing,
+// which otherwise dereferences the (for a tuple, nil) ItemsType.
+func (t *ArrayType) AssignTypeTo(typeable Typeable) TypeCheck {
+	array, ok := typeable.(*Array)
+	if !ok {
+		return TypeCheck{Violations: []error{fmt.Errorf("Type mismatch: expected an array")}}
+	}
+
+	var check TypeCheck
+	if t.Tuple != nil {
+		if len(array.Items) != len(t.Tuple) {
+			check.Violations = append(check.Violations, fmt.Errorf("%s must have exactly %d item(s) (found: %d)", array.Position.AsCompactString(), len(t.Tuple), len(array.Items)))
+		}
+		for i, item := range array.Items {
+			if i >= len(t.Tuple) {
+				break
+			}
+			check.Violations = append(check.Violations, checkArrayItemAgainst(t.Tuple[i], item, array.Position)...)
+		}
+		return check
+	}
+
+	for _, item := range array.Items {
+		check.Violations = append(check.Violations, checkArrayItemAgainst(t.ItemsType, item, array.Position)...)
+	}
+	return check
+}
+
+func (t *ArrayType) CheckType(typeable Typeable) TypeCheck {
+	return t.AssignTypeTo(typeable)
+}
+
+func checkArrayItemAgainst(itemType *ArrayItemType, item *ArrayItem, arrayPos *filepos.Position) []error {
+	if item.Value == nil {
+		if !itemType.Nullable {
+			return []error{fmt.Errorf("%s item must not be null", arrayPos.AsCompactString())}
+		}
+		return nil
+	}
+	if !isAssignableTo(item.Value, itemType.ValueType) {
+		return []error{fmt.Errorf("%s item must be of type %s (found: %T)", arrayPos.AsCompactString(), describeType(itemType.ValueType), item.Value)}
+	}
+	return nil
+}
+
+// describeType renders t the way a schema author would recognize it (the
+// scalar's underlying Go type, e.g. "int" or "string") rather than the
+// internal *yamlmeta.ScalarType wrapper a bare %T would print.
+func describeType(t Type) string {
+	switch typed := t.(type) {
+	case *ScalarType:
+		return fmt.Sprintf("%T", typed.Type)
+	case *AnyType:
+		return "any"
+	default:
+		return fmt.Sprintf("%T", t)
+	}
+}
+
 func (s *DocumentSchema) AssignType(typeable Typeable) TypeCheck {
-	return s.Allowed.AssignTypeTo(typeable)
+	if doc, ok := typeable.(*Document); ok {
+		if m, ok := doc.Value.(*Map); ok {
+			s.Defaults.ApplyTo(m)
+		}
+	}
+
+	check := s.Allowed.AssignTypeTo(typeable)
+
+	if doc, ok := typeable.(*Document); ok {
+		check.Violations = append(check.Violations, CheckValidations(s.Allowed.ValueType, doc.Value)...)
+	}
+
+	return check
+}
+
+// CheckValidations walks value alongside the schema type t, running any
+// @schema/validation constraints found on the way and accumulating their
+// violations rather than stopping at the first one found. Exported so that
+// pkg/schema's DocumentSchema.AssignType can run the same walk.
+func CheckValidations(t Type, value interface{}) []error {
+	var violations []error
+
+	switch typedType := t.(type) {
+	case *MapType:
+		m, ok := value.(*Map)
+		if !ok {
+			return nil
+		}
+		for _, itemType := range typedType.Items {
+			mapItem := findMapItemByKey(m, itemType.Key)
+			if mapItem == nil {
+				continue
+			}
+			violations = append(violations, itemType.Validation.Check(mapItem.Value)...)
+			violations = append(violations, CheckValidations(itemType.ValueType, mapItem.Value)...)
+		}
+	case *ArrayType:
+		a, ok := value.(*Array)
+		if !ok {
+			return nil
+		}
+		if typedType.Tuple != nil {
+			if len(a.Items) != len(typedType.Tuple) {
+				violations = append(violations, fmt.Errorf("%s must have exactly %d item(s) (found: %d)", a.Position.AsCompactString(), len(typedType.Tuple), len(a.Items)))
+			}
+			for i, arrayItem := range a.Items {
+				if i >= len(typedType.Tuple) {
+					break
+				}
+				itemType := typedType.Tuple[i]
+				if arrayItem.Value == nil {
+					if !itemType.Nullable {
+						violations = append(violations, fmt.Errorf("%s item %d must not be null", a.Position.AsCompactString(), i))
+					}
+				} else if !isAssignableTo(arrayItem.Value, itemType.ValueType) {
+					violations = append(violations, fmt.Errorf("%s item %d must be of type %s (found: %T)", a.Position.AsCompactString(), i, describeType(itemType.ValueType), arrayItem.Value))
+				}
+				violations = append(violations, itemType.Validation.Check(arrayItem.Value)...)
+				violations = append(violations, CheckValidations(itemType.ValueType, arrayItem.Value)...)
+			}
+			break
+		}
+		for _, arrayItem := range a.Items {
+			violations = append(violations, typedType.ItemsType.Validation.Check(arrayItem.Value)...)
+			violations = append(violations, CheckValidations(typedType.ItemsType.ValueType, arrayItem.Value)...)
+		}
+	}
+
+	return violations
+}
+
+func findMapItemByKey(m *Map, key interface{}) *MapItem {
+	for _, item := range m.Items {
+		if item.Key == key {
+			return item
+		}
+	}
+	return nil
 }
 
 func (t MapItemType) IsNullable() bool {
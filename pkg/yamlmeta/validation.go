@@ -0,0 +1,222 @@
+// Copyright 2020 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package yamlmeta
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/k14s/ytt/pkg/filepos"
+	"github.com/k14s/ytt/pkg/structmeta"
+	"github.com/k14s/ytt/pkg/template"
+	"github.com/k14s/ytt/pkg/template/core"
+)
+
+const (
+	AnnotationSchemaValidation structmeta.AnnotationName = "schema/validation"
+)
+
+// Validation holds the constraints declared via @schema/validation for a single
+// MapItemType, ArrayItemType, or ScalarType.
+type Validation struct {
+	Min      *float64
+	Max      *float64
+	MinLen   *int
+	MaxLen   *int
+	OneOf    []interface{}
+	Pattern  *string
+	position *filepos.Position
+}
+
+// NewValidation parses the @schema/validation annotation (if present) off of node's
+// annotations, returning nil, nil when the annotation is absent. valueType is the
+// item's declared type (before any @schema/type any=True wrapping), used to check
+// that one_of's values are assignable to it.
+func NewValidation(node Node, valueType Type) (*Validation, error) {
+	anns := template.NewAnnotations(node)
+	annotation, found := anns[AnnotationSchemaValidation]
+	if !found {
+		return nil, nil
+	}
+
+	val := &Validation{position: node.GetPosition()}
+	for _, kwarg := range annotation.Kwargs {
+		name, err := core.NewStarlarkValue(kwarg[0]).AsString()
+		if err != nil {
+			return nil, validationErr(node, err)
+		}
+		goValue, err := core.NewStarlarkValue(kwarg[1]).AsGoValue()
+		if err != nil {
+			return nil, validationErr(node, err)
+		}
+
+		switch name {
+		case "min":
+			f, ok := toFloat64(goValue)
+			if !ok {
+				return nil, validationArgErr("min", node, goValue)
+			}
+			val.Min = &f
+		case "max":
+			f, ok := toFloat64(goValue)
+			if !ok {
+				return nil, validationArgErr("max", node, goValue)
+			}
+			val.Max = &f
+		case "min_len":
+			n, ok := toFloat64(goValue)
+			if !ok {
+				return nil, validationArgErr("min_len", node, goValue)
+			}
+			i := int(n)
+			val.MinLen = &i
+		case "max_len":
+			n, ok := toFloat64(goValue)
+			if !ok {
+				return nil, validationArgErr("max_len", node, goValue)
+			}
+			i := int(n)
+			val.MaxLen = &i
+		case "one_of":
+			items, ok := goValue.([]interface{})
+			if !ok {
+				return nil, validationArgErr("one_of", node, goValue)
+			}
+			typeKwargs, err := SchemaTypeKwargs(node)
+			if err != nil {
+				return nil, err
+			}
+			if !typeKwargs["any"] {
+				for _, item := range items {
+					if !isAssignableTo(item, valueType) {
+						return nil, fmt.Errorf("Processing %s kwarg \"one_of\" (%s): value %#v is not assignable to the declared type", AnnotationSchemaValidation, node.GetPosition().AsCompactString(), item)
+					}
+				}
+			}
+			val.OneOf = items
+		case "pattern":
+			s, ok := goValue.(string)
+			if !ok {
+				return nil, validationArgErr("pattern", node, goValue)
+			}
+			if _, err := regexp.Compile(s); err != nil {
+				return nil, fmt.Errorf("Processing %s (%s): pattern is not a valid regular expression: %s", AnnotationSchemaValidation, node.GetPosition().AsCompactString(), err)
+			}
+			val.Pattern = &s
+		default:
+			return nil, fmt.Errorf("Unknown kwarg %q for %s (%s)", name, AnnotationSchemaValidation, node.GetPosition().AsCompactString())
+		}
+	}
+
+	return val, nil
+}
+
+// Check runs the constraints held by val against value, collecting one violation per
+// failed constraint rather than stopping at the first failure.
+func (val *Validation) Check(value interface{}) []error {
+	if val == nil {
+		return nil
+	}
+
+	var violations []error
+
+	if val.Min != nil || val.Max != nil {
+		if n, ok := toFloat64(value); ok {
+			if val.Min != nil && n < *val.Min {
+				violations = append(violations, fmt.Errorf("%s must be >= %v (found: %v)", val.position.AsCompactString(), *val.Min, n))
+			}
+			if val.Max != nil && n > *val.Max {
+				violations = append(violations, fmt.Errorf("%s must be <= %v (found: %v)", val.position.AsCompactString(), *val.Max, n))
+			}
+		}
+	}
+
+	if val.MinLen != nil || val.MaxLen != nil {
+		if length, ok := lengthOf(value); ok {
+			if val.MinLen != nil && length < *val.MinLen {
+				violations = append(violations, fmt.Errorf("%s must have length >= %v (found: %v)", val.position.AsCompactString(), *val.MinLen, length))
+			}
+			if val.MaxLen != nil && length > *val.MaxLen {
+				violations = append(violations, fmt.Errorf("%s must have length <= %v (found: %v)", val.position.AsCompactString(), *val.MaxLen, length))
+			}
+		}
+	}
+
+	if val.OneOf != nil {
+		var match bool
+		for _, allowed := range val.OneOf {
+			if validationValuesEqual(allowed, value) {
+				match = true
+				break
+			}
+		}
+		if !match {
+			violations = append(violations, fmt.Errorf("%s must be one of %v (found: %v)", val.position.AsCompactString(), val.OneOf, value))
+		}
+	}
+
+	if val.Pattern != nil {
+		if s, ok := value.(string); ok {
+			if matched, _ := regexp.MatchString(*val.Pattern, s); !matched {
+				violations = append(violations, fmt.Errorf("%s must match pattern %q (found: %q)", val.position.AsCompactString(), *val.Pattern, s))
+			}
+		}
+	}
+
+	return violations
+}
+
+// validationValuesEqual compares a one_of entry against value. Starlark-derived
+// ints/floats and YAML-document ints don't share a dynamic type (int vs int64 vs
+// float64), so numeric operands are normalized through toFloat64 and compared by
+// value rather than by raw `==`, which would also risk a panic on a non-comparable
+// dynamic type.
+func validationValuesEqual(allowed, value interface{}) bool {
+	if allowedNum, ok := toFloat64(allowed); ok {
+		valueNum, ok := toFloat64(value)
+		return ok && allowedNum == valueNum
+	}
+	switch a := allowed.(type) {
+	case string:
+		v, ok := value.(string)
+		return ok && a == v
+	case bool:
+		v, ok := value.(bool)
+		return ok && a == v
+	default:
+		return false
+	}
+}
+
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func lengthOf(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case string:
+		return len([]rune(v)), true
+	case *Array:
+		return len(v.Items), true
+	case *Map:
+		return len(v.Items), true
+	}
+	return 0, false
+}
+
+func validationErr(node Node, err error) error {
+	return fmt.Errorf("Processing %s (%s): %s", AnnotationSchemaValidation, node.GetPosition().AsCompactString(), err)
+}
+
+func validationArgErr(kwarg string, node Node, got interface{}) error {
+	return fmt.Errorf("Processing %s kwarg %q (%s): unexpected value %v", AnnotationSchemaValidation, kwarg, node.GetPosition().AsCompactString(), got)
+}